@@ -0,0 +1,37 @@
+// Command forge-server serves greetings over HTTP.
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/anshukaushik4700/Forge/pkg/greet"
+)
+
+type greetResponse struct {
+	Message string `json:"message"`
+}
+
+func greetHandler(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	locale := r.URL.Query().Get("locale")
+	if locale == "" {
+		locale = greet.DefaultLocale
+	}
+
+	g := greet.NewGreeter(locale)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(greetResponse{Message: g.Greet(name)})
+}
+
+func main() {
+	addr := os.Getenv("FORGE_ADDR")
+	if addr == "" {
+		addr = ":8080"
+	}
+
+	http.HandleFunc("/greet", greetHandler)
+	log.Fatal(http.ListenAndServe(addr, nil))
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGreetHandlerTable(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale string
+		want   string
+	}{
+		{"Forge", "", "Hello, Forge!"},
+		{"Alice", "en", "Hello, Alice!"},
+		{"Bob", "es", "¡Hola, Bob!"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/greet?name="+tt.name+"&locale="+tt.locale, nil)
+		rec := httptest.NewRecorder()
+
+		greetHandler(rec, req)
+
+		var got greetResponse
+		if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if got.Message != tt.want {
+			t.Errorf("GET /greet?name=%s&locale=%s = %q; want %q", tt.name, tt.locale, got.Message, tt.want)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Command forge is the Forge CLI.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/anshukaushik4700/Forge/pkg/greet"
+)
+
+func main() {
+	os.Exit(main1())
+}
+
+func main1() int {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+func run(args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: forge <command> [arguments]")
+	}
+
+	switch args[0] {
+	case "greet":
+		return runGreet(args[1:], stdout)
+	default:
+		return fmt.Errorf("forge: unknown command %q", args[0])
+	}
+}
+
+// runGreet splits args into flags and positional arguments before handing
+// them to flag.FlagSet, since flag.Parse stops consuming flags at the
+// first positional argument and "forge greet Forge --locale=es" would
+// otherwise leave "--locale=es" unparsed. The split is flag-aware (it
+// consults fs to see whether a flag takes a value) so that the idiomatic
+// space-separated form "forge greet --locale es Forge" keeps working too.
+func runGreet(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("greet", flag.ContinueOnError)
+	locale := fs.String("locale", greet.DefaultLocale, "BCP-47 locale to greet in")
+
+	var flagArgs, positional []string
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		if a == "--" {
+			positional = append(positional, args[i+1:]...)
+			break
+		}
+		if len(a) == 0 || a[0] != '-' {
+			positional = append(positional, a)
+			continue
+		}
+
+		flagArgs = append(flagArgs, a)
+		if strings.Contains(a, "=") {
+			continue
+		}
+		if f := fs.Lookup(strings.TrimLeft(a, "-")); f != nil {
+			if b, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() {
+				continue
+			}
+			if i+1 >= len(args) {
+				return fmt.Errorf("flag needs an argument: %s", a)
+			}
+			i++
+			flagArgs = append(flagArgs, args[i])
+		}
+	}
+
+	if err := fs.Parse(flagArgs); err != nil {
+		return err
+	}
+
+	if len(positional) != 1 {
+		return fmt.Errorf("usage: forge greet <name> [--locale=xx]")
+	}
+
+	g := greet.NewGreeter(*locale)
+	fmt.Fprintln(stdout, g.Greet(positional[0]))
+	return nil
+}
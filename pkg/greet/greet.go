@@ -0,0 +1,63 @@
+// Package greet implements Forge's small i18n greeting subsystem.
+package greet
+
+import "fmt"
+
+// DefaultLocale is used when a requested locale has no registered template.
+const DefaultLocale = "en"
+
+// Catalog maps a BCP-47 language tag to a greeting template. Each template
+// takes exactly one %s verb for the name being greeted.
+type Catalog map[string]string
+
+// Greeter produces locale-specific greetings from a Catalog.
+type Greeter struct {
+	Locale  string
+	Catalog Catalog
+}
+
+// defaultCatalog seeds the handful of locales Forge ships with out of the box.
+func defaultCatalog() Catalog {
+	return Catalog{
+		"en": "Hello, %s!",
+		"es": "¡Hola, %s!",
+		"ja": "こんにちは、%sさん!",
+	}
+}
+
+// Default is the package-level Greeter backing the Greet helper.
+var Default = NewGreeter(DefaultLocale)
+
+// NewGreeter returns a Greeter for locale, seeded with the built-in catalog.
+func NewGreeter(locale string) *Greeter {
+	return &Greeter{
+		Locale:  locale,
+		Catalog: defaultCatalog(),
+	}
+}
+
+// RegisterLocale adds or overrides the greeting template for tag.
+func (g *Greeter) RegisterLocale(tag, template string) {
+	if g.Catalog == nil {
+		g.Catalog = Catalog{}
+	}
+	g.Catalog[tag] = template
+}
+
+// Greet formats name using g's locale template, falling back to DefaultLocale
+// when the locale has no registered template.
+func (g *Greeter) Greet(name string) string {
+	template, ok := g.Catalog[g.Locale]
+	if !ok {
+		template, ok = g.Catalog[DefaultLocale]
+		if !ok {
+			template = "Hello, %s!"
+		}
+	}
+	return fmt.Sprintf(template, name)
+}
+
+// Greet is a thin wrapper around the package-level Default Greeter.
+func Greet(name string) string {
+	return Default.Greet(name)
+}
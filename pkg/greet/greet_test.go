@@ -0,0 +1,119 @@
+package greet
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestGreetBasic(t *testing.T) {
+	got := Greet("Forge")
+	want := "Hello, Forge!"
+
+	if got != want {
+		t.Fatalf("Greet(\"Forge\") = %q; want %q", got, want)
+	}
+}
+
+func TestGreetTable(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Forge", "Hello, Forge!"},
+		{"Alice", "Hello, Alice!"},
+		{"Bob", "Hello, Bob!"},
+	}
+
+	for _, tt := range tests {
+		got := Greet(tt.name)
+		if got != tt.want {
+			t.Errorf("Greet(%q) = %q; want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func FuzzGreet(f *testing.F) {
+	seeds := []string{
+		"Forge",
+		"Alice",
+		"Bob",
+		"",
+		"é",                       // "e" + combining acute accent (decomposed, not precomposed)
+		"100% sure",                // embedded % verb
+		"\x00",                     // NUL byte
+		"مرحبا",                    // RTL text
+		strings.Repeat("a", 10000), // very long input
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, name string) {
+		got := Greet(name)
+
+		if !strings.HasPrefix(got, "Hello, ") {
+			t.Fatalf("Greet(%q) = %q; missing prefix %q", name, got, "Hello, ")
+		}
+		if !strings.HasSuffix(got, "!") {
+			t.Fatalf("Greet(%q) = %q; missing suffix %q", name, got, "!")
+		}
+		if !strings.Contains(got, name) {
+			t.Fatalf("Greet(%q) = %q; does not contain input verbatim", name, got)
+		}
+		if utf8.ValidString(name) && !utf8.ValidString(got) {
+			t.Fatalf("Greet(%q) = %q; valid UTF-8 input produced invalid UTF-8 output", name, got)
+		}
+	})
+}
+
+func TestGreetEmpty(t *testing.T) {
+	got := Greet("")
+	want := "Hello, !"
+
+	if got != want {
+		t.Fatalf("Greet(\"\") = %q; want %q", got, want)
+	}
+}
+
+func TestGreeterLocales(t *testing.T) {
+	tests := []struct {
+		locale string
+		name   string
+		want   string
+	}{
+		{"en", "Forge", "Hello, Forge!"},
+		{"es", "Forge", "¡Hola, Forge!"},
+		{"ja", "Forge", "こんにちは、Forgeさん!"},
+	}
+
+	for _, tt := range tests {
+		g := NewGreeter(tt.locale)
+		got := g.Greet(tt.name)
+		if got != tt.want {
+			t.Errorf("NewGreeter(%q).Greet(%q) = %q; want %q", tt.locale, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGreeterUnknownLocaleFallsBackToEnglish(t *testing.T) {
+	g := NewGreeter("xx")
+	got := g.Greet("Forge")
+	want := "Hello, Forge!"
+
+	if got != want {
+		t.Fatalf("NewGreeter(\"xx\").Greet(\"Forge\") = %q; want %q", got, want)
+	}
+}
+
+func TestGreeterRegisterLocale(t *testing.T) {
+	g := NewGreeter("fr")
+	g.RegisterLocale("fr", "Salut, %s!")
+
+	got := g.Greet("Forge")
+	want := "Salut, Forge!"
+
+	if got != want {
+		t.Fatalf("Greet after RegisterLocale(\"fr\", ...) = %q; want %q", got, want)
+	}
+}